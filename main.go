@@ -1,6 +1,9 @@
 package main
 
 import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
 	"encoding/xml"
 	"flag"
 	"fmt"
@@ -8,10 +11,16 @@ import (
 	"log"
 	"math"
 	"math/rand"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/graphql-go/graphql/language/ast"
 	"github.com/graphql-go/graphql/language/parser"
+	"gopkg.in/yaml.v3"
 )
 
 type ScalarNode struct {
@@ -25,6 +34,8 @@ type DiagramFormat int
 const (
 	Mermaid DiagramFormat = iota
 	DrawIO
+	PowerPoint
+	XLSX
 )
 
 type MermaidDiagram struct {
@@ -37,12 +48,13 @@ type Position struct {
 }
 
 type ClassNode struct {
-	ID       string
-	Name     string
-	Fields   []Field
-	Position Position
-	Width    float64
-	Height   float64
+	ID         string
+	Name       string
+	Fields     []Field
+	Stereotype string
+	Position   Position
+	Width      float64
+	Height     float64
 }
 
 type Field struct {
@@ -81,6 +93,14 @@ type Diagram struct {
 	format     DiagramFormat
 	maxWidth   float64
 	maxHeight  float64
+
+	// Iterations, Theta and InitialTemperature tune the Fruchterman-Reingold
+	// layout pass run by calculateLayout. Zero means "use the default".
+	Iterations         int
+	Theta              float64
+	InitialTemperature float64
+
+	Config Config
 }
 
 const (
@@ -109,6 +129,157 @@ const (
 	ArgumentStyle   = `text;strokeColor=none;fillColor=none;align=left;verticalAlign=top;spacingLeft=4;spacingRight=4;overflow=hidden;rotatable=0;points=[[0,0.5],[1,0.5]];portConstraint=eastwest;`
 )
 
+// Config externalizes everything that used to be hard-coded: canvas size,
+// layout constants, per-shape styles, and the type filters applied by
+// processSchema. defaultConfig() reproduces today's hard-coded behaviour
+// exactly, so running without -config is unchanged.
+type Config struct {
+	MaxWidth  float64 `json:"maxWidth" yaml:"maxWidth"`
+	MaxHeight float64 `json:"maxHeight" yaml:"maxHeight"`
+
+	ClassWidth        float64 `json:"classWidth" yaml:"classWidth"`
+	ClassHeaderHeight float64 `json:"classHeaderHeight" yaml:"classHeaderHeight"`
+	FieldHeight       float64 `json:"fieldHeight" yaml:"fieldHeight"`
+	HorizontalGap     float64 `json:"horizontalGap" yaml:"horizontalGap"`
+	VerticalGap       float64 `json:"verticalGap" yaml:"verticalGap"`
+	StartX            float64 `json:"startX" yaml:"startX"`
+	StartY            float64 `json:"startY" yaml:"startY"`
+	ScalarWidth       float64 `json:"scalarWidth" yaml:"scalarWidth"`
+	ScalarHeight      float64 `json:"scalarHeight" yaml:"scalarHeight"`
+	DirectiveWidth    float64 `json:"directiveWidth" yaml:"directiveWidth"`
+	DirectiveHeight   float64 `json:"directiveHeight" yaml:"directiveHeight"`
+
+	ClassStyle     string `json:"classStyle" yaml:"classStyle"`
+	FieldStyle     string `json:"fieldStyle" yaml:"fieldStyle"`
+	ScalarStyle    string `json:"scalarStyle" yaml:"scalarStyle"`
+	DirectiveStyle string `json:"directiveStyle" yaml:"directiveStyle"`
+	EdgeStyle      string `json:"edgeStyle" yaml:"edgeStyle"`
+	ArgumentStyle  string `json:"argumentStyle" yaml:"argumentStyle"`
+
+	Filters FilterConfig `json:"filters" yaml:"filters"`
+}
+
+// FilterConfig lets callers render a focused subgraph of a large (e.g.
+// federated) schema instead of everything processSchema finds.
+type FilterConfig struct {
+	IncludeTypes   []string `json:"includeTypes" yaml:"includeTypes"`
+	ExcludeTypes   []string `json:"excludeTypes" yaml:"excludeTypes"`
+	HideScalars    bool     `json:"hideScalars" yaml:"hideScalars"`
+	HideDirectives bool     `json:"hideDirectives" yaml:"hideDirectives"`
+	HideBuiltins   bool     `json:"hideBuiltins" yaml:"hideBuiltins"`
+}
+
+func defaultConfig() Config {
+	return Config{
+		MaxWidth:  1920,
+		MaxHeight: 1080,
+
+		ClassWidth:        ClassWidth,
+		ClassHeaderHeight: ClassHeaderHeight,
+		FieldHeight:       FieldHeight,
+		HorizontalGap:     HorizontalGap,
+		VerticalGap:       VerticalGap,
+		StartX:            StartX,
+		StartY:            StartY,
+		ScalarWidth:       ScalarWidth,
+		ScalarHeight:      ScalarHeight,
+		DirectiveWidth:    DirectiveWidth,
+		DirectiveHeight:   DirectiveHeight,
+
+		ClassStyle:     ClassStyle,
+		FieldStyle:     FieldStyle,
+		ScalarStyle:    ScalarStyle,
+		DirectiveStyle: DirectiveStyle,
+		EdgeStyle:      EdgeStyle,
+		ArgumentStyle:  ArgumentStyle,
+	}
+}
+
+// loadConfig reads a JSON or YAML file (decided by its extension) on top of
+// defaultConfig(), so a config file only needs to set the fields it wants to
+// override.
+func loadConfig(path string) (Config, error) {
+	cfg := defaultConfig()
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return Config{}, fmt.Errorf("reading config file: %w", err)
+	}
+
+	if strings.HasSuffix(path, ".yaml") || strings.HasSuffix(path, ".yml") {
+		if err := yaml.Unmarshal(data, &cfg); err != nil {
+			return Config{}, fmt.Errorf("parsing YAML config: %w", err)
+		}
+	} else {
+		if err := json.Unmarshal(data, &cfg); err != nil {
+			return Config{}, fmt.Errorf("parsing JSON config: %w", err)
+		}
+	}
+
+	if err := validateFilterPatterns(cfg.Filters); err != nil {
+		return Config{}, err
+	}
+
+	return cfg, nil
+}
+
+// validateFilterPatterns compiles every IncludeTypes/ExcludeTypes pattern so
+// a typo surfaces as a config error instead of matchesTypeFilters silently
+// treating the broken pattern as a non-match, which can empty an entire
+// diagram with no indication why.
+func validateFilterPatterns(filters FilterConfig) error {
+	for _, pattern := range filters.IncludeTypes {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return fmt.Errorf("invalid includeTypes pattern %q: %w", pattern, err)
+		}
+	}
+	for _, pattern := range filters.ExcludeTypes {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return fmt.Errorf("invalid excludeTypes pattern %q: %w", pattern, err)
+		}
+	}
+	return nil
+}
+
+// matchesTypeFilters reports whether a type name should be included in the
+// diagram per FilterConfig.IncludeTypes/ExcludeTypes. An exclude match always
+// wins; when IncludeTypes is non-empty a name must match one of its patterns.
+func matchesTypeFilters(name string, filters FilterConfig) bool {
+	for _, pattern := range filters.ExcludeTypes {
+		if re, err := regexp.Compile(pattern); err == nil && re.MatchString(name) {
+			return false
+		}
+	}
+
+	if len(filters.IncludeTypes) == 0 {
+		return true
+	}
+	for _, pattern := range filters.IncludeTypes {
+		if re, err := regexp.Compile(pattern); err == nil && re.MatchString(name) {
+			return true
+		}
+	}
+	return false
+}
+
+func isBuiltinScalarName(name string) bool {
+	switch name {
+	case "String", "Int", "Float", "Boolean", "ID":
+		return true
+	default:
+		return false
+	}
+}
+
+func isBuiltinDirectiveName(name string) bool {
+	switch name {
+	case "skip", "include", "deprecated", "specifiedBy":
+		return true
+	default:
+		return false
+	}
+}
+
 type MxFile struct {
 	XMLName xml.Name `xml:"mxfile"`
 	Diagram MxDiagram
@@ -151,78 +322,263 @@ type MxGeometry struct {
 	Relative string   `xml:"relative,attr,omitempty"`
 }
 
-func calculateLayout(d *Diagram) {
-	iterations := 100
-	totalNodes := len(d.Classes) + len(d.Scalars) + len(d.Directives)
-	k := math.Sqrt(d.maxWidth * d.maxHeight / float64(totalNodes))
+// quadTreeMaxDepth and quadTreeMinSize bound how far insert will subdivide:
+// without a bailout, two nodes that share (or converge to, e.g. via boundary
+// clamping) the same position force subdivide to keep halving a cell that
+// never separates them, recursing until the stack overflows.
+const (
+	quadTreeMaxDepth = 32
+	quadTreeMinSize  = 1e-6
+)
+
+// quadTree is a Barnes-Hut spatial index over node positions, used by
+// calculateLayout to approximate repulsion in O(N log N) for large graphs.
+type quadTree struct {
+	xmin, ymin, xmax, ymax float64
+	count                  int
+	sumX, sumY             float64
+	children               []*quadTree
+	leaf                   []*ClassNode
+}
+
+func newQuadTree(xmin, ymin, xmax, ymax float64) *quadTree {
+	return &quadTree{xmin: xmin, ymin: ymin, xmax: xmax, ymax: ymax}
+}
+
+func (q *quadTree) size() float64 {
+	return q.xmax - q.xmin
+}
 
-	allNodes := make([]*ClassNode, 0, totalNodes)
-	allNodes = append(allNodes, d.Classes...)
+func (q *quadTree) insert(n *ClassNode) {
+	q.insertAt(n, 0)
+}
+
+func (q *quadTree) insertAt(n *ClassNode, depth int) {
+	q.count++
+	q.sumX += n.Position.X
+	q.sumY += n.Position.Y
+
+	if q.children == nil && len(q.leaf) == 0 {
+		q.leaf = append(q.leaf, n)
+		return
+	}
+	if q.children == nil {
+		if depth >= quadTreeMaxDepth || q.size() < quadTreeMinSize {
+			// Cell can't usefully subdivide further (max depth reached or
+			// coincident points) - keep treating it as a single leaf whose
+			// mass is the sum of everything inserted into it.
+			q.leaf = append(q.leaf, n)
+			return
+		}
+		q.subdivide()
+		for _, existing := range q.leaf {
+			q.insertIntoChild(existing, depth+1)
+		}
+		q.leaf = nil
+	}
+	q.insertIntoChild(n, depth+1)
+}
+
+func (q *quadTree) subdivide() {
+	midX := (q.xmin + q.xmax) / 2
+	midY := (q.ymin + q.ymax) / 2
+	q.children = []*quadTree{
+		newQuadTree(q.xmin, q.ymin, midX, midY),
+		newQuadTree(midX, q.ymin, q.xmax, midY),
+		newQuadTree(q.xmin, midY, midX, q.ymax),
+		newQuadTree(midX, midY, q.xmax, q.ymax),
+	}
+}
+
+func (q *quadTree) insertIntoChild(n *ClassNode, depth int) {
+	midX := (q.xmin + q.xmax) / 2
+	midY := (q.ymin + q.ymax) / 2
+	idx := 0
+	if n.Position.X >= midX {
+		idx++
+	}
+	if n.Position.Y >= midY {
+		idx += 2
+	}
+	q.children[idx].insertAt(n, depth)
+}
+
+func (q *quadTree) centerOfMass() (float64, float64) {
+	return q.sumX / float64(q.count), q.sumY / float64(q.count)
+}
+
+// addRepulsion accumulates the repulsive force(s) that q exerts on v into disp,
+// treating any cell whose size/distance ratio is below theta as a single body
+// at its center of mass rather than descending into its children.
+func (q *quadTree) addRepulsion(v *ClassNode, k, theta float64, disp *Position) {
+	if q.count == 0 || (len(q.leaf) == 1 && q.leaf[0] == v) {
+		return
+	}
+	comX, comY := q.centerOfMass()
+	dx := v.Position.X - comX
+	dy := v.Position.Y - comY
+	dist := math.Max(0.1, math.Sqrt(dx*dx+dy*dy))
+
+	if q.children == nil || q.size()/dist < theta {
+		force := (k * k / dist) * float64(q.count)
+		disp.X += (dx / dist) * force
+		disp.Y += (dy / dist) * force
+		return
+	}
+
+	for _, c := range q.children {
+		c.addRepulsion(v, k, theta, disp)
+	}
+}
+
+// barnesHutThreshold is the node count above which calculateLayout switches
+// repulsion from brute-force O(N^2) to a Barnes-Hut quadtree approximation.
+const barnesHutThreshold = 200
+
+// calculateLayout runs a Fruchterman-Reingold force-directed layout over every
+// ClassNode, synthesizing a persistent ClassNode for each scalar and directive
+// (added to d.Classes on first use, so repeat calls don't duplicate them) and
+// positioning all of them together with the Relation edges between them.
+// Displacements are accumulated into a temporary buffer per iteration and a
+// cooling temperature caps how far any node can move, so the layout converges
+// instead of oscillating.
+func calculateLayout(d *Diagram) {
+	if d.Iterations == 0 {
+		d.Iterations = 100
+	}
+	if d.Theta == 0 {
+		d.Theta = 0.9
+	}
+	if d.InitialTemperature == 0 {
+		d.InitialTemperature = d.maxWidth / 10
+	}
+	if d.Config.ClassWidth == 0 {
+		d.Config = defaultConfig()
+	}
 
+	existing := make(map[string]bool, len(d.Classes))
+	for _, c := range d.Classes {
+		existing[c.ID] = true
+	}
 	for _, scalar := range d.Scalars {
-		allNodes = append(allNodes, &ClassNode{
-			ID:       "scalar_" + scalar.Name,
+		id := "scalar_" + scalar.Name
+		if existing[id] {
+			continue
+		}
+		d.Classes = append(d.Classes, &ClassNode{
+			ID:       id,
 			Name:     scalar.Name,
-			Width:    ScalarWidth,
-			Height:   ScalarHeight,
+			Width:    d.Config.ScalarWidth,
+			Height:   d.Config.ScalarHeight,
 			Position: Position{X: rand.Float64() * d.maxWidth, Y: rand.Float64() * d.maxHeight},
 		})
+		existing[id] = true
 	}
-
 	for _, directive := range d.Directives {
-		height := DirectiveHeight + float64(len(directive.Arguments))*FieldHeight
-		allNodes = append(allNodes, &ClassNode{
-			ID:       "directive_" + directive.Name,
+		id := "directive_" + directive.Name
+		if existing[id] {
+			continue
+		}
+		height := d.Config.DirectiveHeight + float64(len(directive.Arguments))*d.Config.FieldHeight
+		d.Classes = append(d.Classes, &ClassNode{
+			ID:       id,
 			Name:     "@" + directive.Name,
-			Width:    DirectiveWidth,
+			Width:    d.Config.DirectiveWidth,
 			Height:   height,
 			Position: Position{X: rand.Float64() * d.maxWidth, Y: rand.Float64() * d.maxHeight},
 		})
+		existing[id] = true
 	}
 
-	for i := 0; i < iterations; i++ {
-		for _, v := range allNodes {
-			for _, u := range allNodes {
-				if v != u {
+	allNodes := d.Classes
+	totalNodes := len(allNodes)
+	if totalNodes == 0 {
+		return
+	}
+	k := math.Sqrt(d.maxWidth * d.maxHeight / float64(totalNodes))
+
+	nodeIndex := make(map[string]int, totalNodes)
+	for i, n := range allNodes {
+		nodeIndex[n.Name] = i
+	}
+
+	disp := make([]Position, totalNodes)
+	useBarnesHut := totalNodes > barnesHutThreshold
+
+	for i := 0; i < d.Iterations; i++ {
+		for idx := range disp {
+			disp[idx] = Position{}
+		}
+
+		if useBarnesHut {
+			tree := newQuadTree(0, 0, d.maxWidth, d.maxHeight)
+			for _, n := range allNodes {
+				tree.insert(n)
+			}
+			for vi, v := range allNodes {
+				tree.addRepulsion(v, k, d.Theta, &disp[vi])
+			}
+		} else {
+			for vi, v := range allNodes {
+				for _, u := range allNodes {
+					if v == u {
+						continue
+					}
 					dx := v.Position.X - u.Position.X
 					dy := v.Position.Y - u.Position.Y
 					dist := math.Max(0.1, math.Sqrt(dx*dx+dy*dy))
 					force := (k * k) / dist
-					v.Position.X += (dx / dist) * force
-					v.Position.Y += (dy / dist) * force
+					disp[vi].X += (dx / dist) * force
+					disp[vi].Y += (dy / dist) * force
 				}
 			}
 		}
 
 		for _, rel := range d.Relations {
-			var from, to *ClassNode
-			for _, node := range allNodes {
-				if node.Name == rel.From {
-					from = node
-				}
-				if node.Name == rel.To {
-					to = node
-				}
+			fi, fok := nodeIndex[rel.From]
+			ti, tok := nodeIndex[rel.To]
+			if !fok || !tok {
+				continue
 			}
-			if from != nil && to != nil {
-				dx := from.Position.X - to.Position.X
-				dy := from.Position.Y - to.Position.Y
-				dist := math.Max(0.1, math.Sqrt(dx*dx+dy*dy))
-				force := (dist * dist) / k
-				dx = (dx / dist) * force
-				dy = (dy / dist) * force
-				from.Position.X -= dx
-				from.Position.Y -= dy
-				to.Position.X += dx
-				to.Position.Y += dy
+			from, to := allNodes[fi], allNodes[ti]
+			dx := from.Position.X - to.Position.X
+			dy := from.Position.Y - to.Position.Y
+			dist := math.Max(0.1, math.Sqrt(dx*dx+dy*dy))
+			force := (dist * dist) / k
+			fdx := (dx / dist) * force
+			fdy := (dy / dist) * force
+			disp[fi].X -= fdx
+			disp[fi].Y -= fdy
+			disp[ti].X += fdx
+			disp[ti].Y += fdy
+		}
+
+		t := d.InitialTemperature * (1 - float64(i)/float64(d.Iterations))
+		for idx, n := range allNodes {
+			dLen := math.Sqrt(disp[idx].X*disp[idx].X + disp[idx].Y*disp[idx].Y)
+			if dLen > 0 {
+				limited := math.Min(dLen, t)
+				n.Position.X += (disp[idx].X / dLen) * limited
+				n.Position.Y += (disp[idx].Y / dLen) * limited
 			}
+			n.Position.X = math.Max(0, math.Min(d.maxWidth, n.Position.X))
+			n.Position.Y = math.Max(0, math.Min(d.maxHeight, n.Position.Y))
 		}
 	}
 }
 
+// generateDrawIOXML lays out classes, scalars and directives together in a
+// single calculateLayout pass (it synthesizes and persists the scalar and
+// directive nodes into d.Classes itself) and then renders each by its ID
+// prefix: plain classes, "scalar_*" and "directive_*".
 func generateDrawIOXML(d *Diagram) []byte {
 	calculateLayout(d)
 
+	directiveByName := make(map[string]*DirectiveNode, len(d.Directives))
+	for _, directive := range d.Directives {
+		directiveByName[directive.Name] = directive
+	}
+
 	mxFile := MxFile{
 		Diagram: MxDiagram{
 			Name: "GraphQL Schema",
@@ -237,44 +593,110 @@ func generateDrawIOXML(d *Diagram) []byte {
 		},
 	}
 
-	for _, class := range d.Classes {
-		height := ClassHeaderHeight + (float64(len(class.Fields)) * FieldHeight)
+	for _, node := range d.Classes {
+		switch {
+		case strings.HasPrefix(node.ID, "scalar_"):
+			scalarCell := MxCell{
+				ID:     node.ID,
+				Value:  node.Name,
+				Style:  d.Config.ScalarStyle,
+				Parent: "1",
+				Vertex: "1",
+				Geometry: &MxGeometry{
+					X:      node.Position.X,
+					Y:      node.Position.Y,
+					Width:  d.Config.ScalarWidth,
+					Height: d.Config.ScalarHeight,
+				},
+			}
+			mxFile.Diagram.Model.Root.Cells = append(mxFile.Diagram.Model.Root.Cells, scalarCell)
+
+		case strings.HasPrefix(node.ID, "directive_"):
+			directive := directiveByName[strings.TrimPrefix(node.Name, "@")]
+			directiveCell := MxCell{
+				ID:     node.ID,
+				Value:  fmt.Sprintf("%s\non %s", node.Name, strings.Join(directive.Locations, ", ")),
+				Style:  d.Config.DirectiveStyle,
+				Parent: "1",
+				Vertex: "1",
+				Geometry: &MxGeometry{
+					X:      node.Position.X,
+					Y:      node.Position.Y,
+					Width:  node.Width,
+					Height: node.Height,
+				},
+			}
+			mxFile.Diagram.Model.Root.Cells = append(mxFile.Diagram.Model.Root.Cells, directiveCell)
 
-		classCell := MxCell{
-			ID:     class.ID,
-			Value:  class.Name,
-			Style:  ClassStyle,
-			Parent: "1",
-			Vertex: "1",
-			Geometry: &MxGeometry{
-				X:      class.Position.X,
-				Y:      class.Position.Y,
-				Width:  ClassWidth,
-				Height: height,
-			},
-		}
-		mxFile.Diagram.Model.Root.Cells = append(mxFile.Diagram.Model.Root.Cells, classCell)
+			for i, arg := range directive.Arguments {
+				argValue := fmt.Sprintf("%s: %s", arg.Name, arg.Type)
+				if arg.DefaultValue != "" {
+					argValue += fmt.Sprintf(" = %s", arg.DefaultValue)
+				}
 
-		for i, field := range class.Fields {
-			fieldValue := field.Name + ": " + field.Type
-			if field.IsRequired {
-				fieldValue += "!"
+				argCell := MxCell{
+					ID:     fmt.Sprintf("%s_arg%d", node.ID, i),
+					Value:  argValue,
+					Style:  d.Config.ArgumentStyle,
+					Parent: node.ID,
+					Vertex: "1",
+					Geometry: &MxGeometry{
+						X:      0,
+						Y:      d.Config.DirectiveHeight + float64(i)*d.Config.FieldHeight,
+						Width:  d.Config.DirectiveWidth,
+						Height: d.Config.FieldHeight,
+					},
+				}
+				mxFile.Diagram.Model.Root.Cells = append(mxFile.Diagram.Model.Root.Cells, argCell)
 			}
 
-			fieldCell := MxCell{
-				ID:     fmt.Sprintf("%s_f%d", class.ID, i),
-				Value:  fieldValue,
-				Style:  FieldStyle,
-				Parent: class.ID,
+		default:
+			height := d.Config.ClassHeaderHeight + (float64(len(node.Fields)) * d.Config.FieldHeight)
+
+			value := node.Name
+			if node.Stereotype != "" {
+				value = fmt.Sprintf("%s\n«%s»", node.Name, node.Stereotype)
+			}
+
+			classCell := MxCell{
+				ID:     node.ID,
+				Value:  value,
+				Style:  d.Config.ClassStyle,
+				Parent: "1",
 				Vertex: "1",
 				Geometry: &MxGeometry{
-					X:      0,
-					Y:      ClassHeaderHeight + float64(i)*FieldHeight,
-					Width:  ClassWidth,
-					Height: FieldHeight,
+					X:      node.Position.X,
+					Y:      node.Position.Y,
+					Width:  d.Config.ClassWidth,
+					Height: height,
 				},
 			}
-			mxFile.Diagram.Model.Root.Cells = append(mxFile.Diagram.Model.Root.Cells, fieldCell)
+			mxFile.Diagram.Model.Root.Cells = append(mxFile.Diagram.Model.Root.Cells, classCell)
+
+			for i, field := range node.Fields {
+				fieldValue := field.Name
+				if field.Type != "" {
+					fieldValue += ": " + field.Type
+				}
+				if field.IsRequired {
+					fieldValue += "!"
+				}
+
+				fieldCell := MxCell{
+					ID:     fmt.Sprintf("%s_f%d", node.ID, i),
+					Value:  fieldValue,
+					Style:  d.Config.FieldStyle,
+					Parent: node.ID,
+					Vertex: "1",
+					Geometry: &MxGeometry{
+						X:      0,
+						Y:      d.Config.ClassHeaderHeight + float64(i)*d.Config.FieldHeight,
+						Width:  d.Config.ClassWidth,
+						Height: d.Config.FieldHeight,
+					},
+				}
+				mxFile.Diagram.Model.Root.Cells = append(mxFile.Diagram.Model.Root.Cells, fieldCell)
+			}
 		}
 	}
 
@@ -285,7 +707,7 @@ func generateDrawIOXML(d *Diagram) []byte {
 			Edge:   "1",
 			Source: rel.From,
 			Target: rel.To,
-			Style:  EdgeStyle,
+			Style:  d.Config.EdgeStyle,
 			Geometry: &MxGeometry{
 				Relative: "1",
 			},
@@ -293,101 +715,822 @@ func generateDrawIOXML(d *Diagram) []byte {
 		mxFile.Diagram.Model.Root.Cells = append(mxFile.Diagram.Model.Root.Cells, edgeCell)
 	}
 
-	for _, scalar := range d.Scalars {
-		scalarNode := &ClassNode{
-			ID:       "scalar_" + scalar.Name,
-			Name:     scalar.Name,
-			Width:    ScalarWidth,
-			Height:   ScalarHeight,
-			Position: Position{},
-		}
-		d.Classes = append(d.Classes, scalarNode)
+	output, _ := xml.MarshalIndent(mxFile, "", "    ")
+	return output
+}
+
+// emuPerPixel converts the px-based layout coordinates calculateLayout already
+// produces into EMUs (English Metric Units), the unit OOXML drawing parts use.
+const emuPerPixel = 9525
+
+func emu(px float64) string {
+	return strconv.FormatInt(int64(px*emuPerPixel), 10)
+}
+
+type pptxOff struct {
+	X string `xml:"x,attr"`
+	Y string `xml:"y,attr"`
+}
+
+type pptxExt struct {
+	Cx string `xml:"cx,attr"`
+	Cy string `xml:"cy,attr"`
+}
+
+type pptxXfrm struct {
+	Off pptxOff `xml:"a:off"`
+	Ext pptxExt `xml:"a:ext"`
+}
+
+type pptxPrstGeom struct {
+	Prst  string `xml:"prst,attr"`
+	AvLst string `xml:"a:avLst"`
+}
+
+type pptxCNvPr struct {
+	ID   string `xml:"id,attr"`
+	Name string `xml:"name,attr"`
+}
+
+type pptxNvSpPr struct {
+	CNvPr   pptxCNvPr `xml:"p:cNvPr"`
+	CNvSpPr string    `xml:"p:cNvSpPr"`
+	NvPr    string    `xml:"p:nvPr"`
+}
+
+type pptxSpPr struct {
+	Xfrm     pptxXfrm     `xml:"a:xfrm"`
+	PrstGeom pptxPrstGeom `xml:"a:prstGeom"`
+}
+
+type pptxRun struct {
+	T string `xml:"a:t"`
+}
+
+type pptxParagraph struct {
+	R pptxRun `xml:"a:r"`
+}
+
+type pptxTxBody struct {
+	BodyPr string          `xml:"a:bodyPr"`
+	P      []pptxParagraph `xml:"a:p"`
+}
+
+type pptxSp struct {
+	NvSpPr pptxNvSpPr `xml:"p:nvSpPr"`
+	SpPr   pptxSpPr   `xml:"p:spPr"`
+	TxBody pptxTxBody `xml:"p:txBody"`
+}
+
+type pptxNvCxnSpPr struct {
+	CNvPr      pptxCNvPr `xml:"p:cNvPr"`
+	CNvCxnSpPr string    `xml:"p:cNvCxnSpPr"`
+	NvPr       string    `xml:"p:nvPr"`
+}
+
+type pptxCxnSp struct {
+	NvCxnSpPr pptxNvCxnSpPr `xml:"p:nvCxnSpPr"`
+	SpPr      pptxSpPr      `xml:"p:spPr"`
+}
+
+type pptxSpTree struct {
+	NvGrpSpPr string      `xml:",innerxml"`
+	Sp        []pptxSp    `xml:"p:sp"`
+	CxnSp     []pptxCxnSp `xml:"p:cxnSp"`
+}
+
+type pptxCSld struct {
+	SpTree pptxSpTree `xml:"p:spTree"`
+}
+
+type pptxSlide struct {
+	XMLName xml.Name `xml:"p:sld"`
+	XmlnsA  string   `xml:"xmlns:a,attr"`
+	XmlnsR  string   `xml:"xmlns:r,attr"`
+	XmlnsP  string   `xml:"xmlns:p,attr"`
+	CSld    pptxCSld `xml:"p:cSld"`
+}
+
+const pptxGroupShapeProps = `<p:nvGrpSpPr><p:cNvPr id="1" name=""/><p:cNvGrpSpPr/><p:nvPr/></p:nvGrpSpPr>` +
+	`<p:grpSpPr><a:xfrm><a:off x="0" y="0"/><a:ext cx="0" cy="0"/><a:chOff x="0" y="0"/><a:chExt cx="0" cy="0"/></a:xfrm></p:grpSpPr>`
+
+// buildPPTXShape renders a single node (class, scalar or directive) as a
+// rectangular shape positioned the same way calculateLayout placed it. text
+// is what's actually drawn in the shape, which may differ from name (e.g. to
+// add a "«stereotype»" suffix) while name is still used for the shape's id.
+func buildPPTXShape(id int, name, text string, pos Position, width, height float64) pptxSp {
+	return pptxSp{
+		NvSpPr: pptxNvSpPr{
+			CNvPr:   pptxCNvPr{ID: strconv.Itoa(id), Name: name},
+			CNvSpPr: "",
+			NvPr:    "",
+		},
+		SpPr: pptxSpPr{
+			Xfrm: pptxXfrm{
+				Off: pptxOff{X: emu(pos.X), Y: emu(pos.Y)},
+				Ext: pptxExt{Cx: emu(width), Cy: emu(height)},
+			},
+			PrstGeom: pptxPrstGeom{Prst: "rect"},
+		},
+		TxBody: pptxTxBody{
+			P: []pptxParagraph{{R: pptxRun{T: text}}},
+		},
+	}
+}
+
+// buildPPTXConnector renders a Relation as a straight connector shape running
+// between the centers of its source and target nodes.
+func buildPPTXConnector(id int, from, to *ClassNode) pptxCxnSp {
+	fromX := from.Position.X + from.Width/2
+	fromY := from.Position.Y + from.Height/2
+	toX := to.Position.X + to.Width/2
+	toY := to.Position.Y + to.Height/2
+
+	x, width := fromX, toX-fromX
+	if width < 0 {
+		x, width = toX, -width
+	}
+	y, height := fromY, toY-fromY
+	if height < 0 {
+		y, height = toY, -height
 	}
 
+	return pptxCxnSp{
+		NvCxnSpPr: pptxNvCxnSpPr{
+			CNvPr: pptxCNvPr{ID: strconv.Itoa(id), Name: fmt.Sprintf("%s-%s", from.Name, to.Name)},
+		},
+		SpPr: pptxSpPr{
+			Xfrm: pptxXfrm{
+				Off: pptxOff{X: emu(x), Y: emu(y)},
+				Ext: pptxExt{Cx: emu(width), Cy: emu(height)},
+			},
+			PrstGeom: pptxPrstGeom{Prst: "line"},
+		},
+	}
+}
+
+// generatePPTX lays out the diagram exactly as generateDrawIOXML does, then
+// emits it as a minimal OOXML .pptx: one slide whose shapes and connectors
+// mirror the ClassNode/ScalarNode/DirectiveNode positions and Relations.
+func generatePPTX(d *Diagram) []byte {
 	calculateLayout(d)
 
-	for _, scalar := range d.Scalars {
-		scalarCell := MxCell{
-			ID:     "scalar_" + scalar.Name,
-			Value:  scalar.Name,
-			Style:  ScalarStyle,
-			Parent: "1",
-			Vertex: "1",
-			Geometry: &MxGeometry{
-				X:      scalar.Position.X,
-				Y:      scalar.Position.Y,
-				Width:  ScalarWidth,
-				Height: ScalarHeight,
+	allNodes := d.Classes
+	byName := make(map[string]*ClassNode, len(allNodes))
+	for _, node := range allNodes {
+		byName[node.Name] = node
+	}
+
+	shapes := make([]pptxSp, 0, len(allNodes))
+	for i, node := range allNodes {
+		height := node.Height
+		if height == 0 {
+			height = d.Config.ClassHeaderHeight + float64(len(node.Fields))*d.Config.FieldHeight
+		}
+		width := node.Width
+		if width == 0 {
+			width = d.Config.ClassWidth
+		}
+		text := node.Name
+		if node.Stereotype != "" {
+			text = fmt.Sprintf("%s «%s»", node.Name, node.Stereotype)
+		}
+		shapes = append(shapes, buildPPTXShape(i+2, node.Name, text, node.Position, width, height))
+	}
+
+	connectors := make([]pptxCxnSp, 0, len(d.Relations))
+	for i, rel := range d.Relations {
+		from, to := byName[rel.From], byName[rel.To]
+		if from != nil && to != nil {
+			connectors = append(connectors, buildPPTXConnector(len(allNodes)+i+2, from, to))
+		}
+	}
+
+	slide := pptxSlide{
+		XmlnsA: "http://schemas.openxmlformats.org/drawingml/2006/main",
+		XmlnsR: "http://schemas.openxmlformats.org/officeDocument/2006/relationships",
+		XmlnsP: "http://schemas.openxmlformats.org/presentationml/2006/main",
+		CSld: pptxCSld{
+			SpTree: pptxSpTree{
+				NvGrpSpPr: pptxGroupShapeProps,
+				Sp:        shapes,
+				CxnSp:     connectors,
 			},
+		},
+	}
+
+	slideXML, _ := xml.Marshal(slide)
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	writeOOXMLZipPart(zw, "[Content_Types].xml", pptxContentTypesXML)
+	writeOOXMLZipPart(zw, "_rels/.rels", pptxRootRelsXML)
+	writeOOXMLZipPart(zw, "ppt/presentation.xml", buildPPTXPresentationXML(d.maxWidth, d.maxHeight))
+	writeOOXMLZipPart(zw, "ppt/_rels/presentation.xml.rels", pptxPresentationRelsXML)
+	writeOOXMLZipPart(zw, "ppt/slideMasters/slideMaster1.xml", pptxSlideMasterXML)
+	writeOOXMLZipPart(zw, "ppt/slideMasters/_rels/slideMaster1.xml.rels", pptxSlideMasterRelsXML)
+	writeOOXMLZipPart(zw, "ppt/slideLayouts/slideLayout1.xml", pptxSlideLayoutXML)
+	writeOOXMLZipPart(zw, "ppt/slideLayouts/_rels/slideLayout1.xml.rels", pptxSlideLayoutRelsXML)
+	writeOOXMLZipPart(zw, "ppt/slides/slide1.xml", xml.Header+string(slideXML))
+	writeOOXMLZipPart(zw, "ppt/slides/_rels/slide1.xml.rels", pptxSlideRelsXML)
+	zw.Close()
+
+	return buf.Bytes()
+}
+
+func writeOOXMLZipPart(zw *zip.Writer, name, content string) {
+	w, err := zw.Create(name)
+	if err != nil {
+		log.Printf("Error creating pptx part %s: %v", name, err)
+		return
+	}
+	w.Write([]byte(content))
+}
+
+const pptxContentTypesXML = xml.Header + `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">
+  <Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>
+  <Default Extension="xml" ContentType="application/xml"/>
+  <Override PartName="/ppt/presentation.xml" ContentType="application/vnd.openxmlformats-officedocument.presentationml.presentation.main+xml"/>
+  <Override PartName="/ppt/slideMasters/slideMaster1.xml" ContentType="application/vnd.openxmlformats-officedocument.presentationml.slideMaster+xml"/>
+  <Override PartName="/ppt/slideLayouts/slideLayout1.xml" ContentType="application/vnd.openxmlformats-officedocument.presentationml.slideLayout+xml"/>
+  <Override PartName="/ppt/slides/slide1.xml" ContentType="application/vnd.openxmlformats-officedocument.presentationml.slide+xml"/>
+</Types>`
+
+const pptxRootRelsXML = xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+  <Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="ppt/presentation.xml"/>
+</Relationships>`
+
+const pptxPresentationXMLTemplate = xml.Header + `<p:presentation xmlns:a="http://schemas.openxmlformats.org/drawingml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships" xmlns:p="http://schemas.openxmlformats.org/presentationml/2006/main">
+  <p:sldMasterIdLst><p:sldMasterId id="2147483648" r:id="rId1"/></p:sldMasterIdLst>
+  <p:sldIdLst><p:sldId id="256" r:id="rId2"/></p:sldIdLst>
+  <p:sldSz cx="%s" cy="%s"/>
+  <p:notesSz cx="6858000" cy="9144000"/>
+</p:presentation>`
+
+// pptxMinSlideEMU/pptxMaxSlideEMU are PowerPoint's supported slide dimension
+// range (roughly 1in to 56in per side), in EMU.
+const (
+	pptxMinSlideEMU = 914400
+	pptxMaxSlideEMU = 51206400
+)
+
+// buildPPTXPresentationXML sets the slide size to match the canvas node
+// positions were actually laid out in (d.maxWidth/d.maxHeight, converted to
+// EMU), clamped to what PowerPoint supports. Without this, shapes placed
+// near the edge of a canvas larger than the old hardcoded 12192000x6858000
+// slide would render off-slide.
+func buildPPTXPresentationXML(maxWidth, maxHeight float64) string {
+	cx := clampEMU(maxWidth * emuPerPixel)
+	cy := clampEMU(maxHeight * emuPerPixel)
+	return fmt.Sprintf(pptxPresentationXMLTemplate, strconv.FormatInt(cx, 10), strconv.FormatInt(cy, 10))
+}
+
+func clampEMU(v float64) int64 {
+	emu := int64(v)
+	if emu < pptxMinSlideEMU {
+		return pptxMinSlideEMU
+	}
+	if emu > pptxMaxSlideEMU {
+		return pptxMaxSlideEMU
+	}
+	return emu
+}
+
+const pptxPresentationRelsXML = xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+  <Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/slideMaster" Target="slideMasters/slideMaster1.xml"/>
+  <Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/slide" Target="slides/slide1.xml"/>
+</Relationships>`
+
+const pptxSlideMasterXML = xml.Header + `<p:sldMaster xmlns:a="http://schemas.openxmlformats.org/drawingml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships" xmlns:p="http://schemas.openxmlformats.org/presentationml/2006/main">
+  <p:cSld>
+    <p:spTree>
+      <p:nvGrpSpPr><p:cNvPr id="1" name=""/><p:cNvGrpSpPr/><p:nvPr/></p:nvGrpSpPr>
+      <p:grpSpPr/>
+    </p:spTree>
+  </p:cSld>
+  <p:sldLayoutIdLst><p:sldLayoutId id="2147483649" r:id="rId1"/></p:sldLayoutIdLst>
+</p:sldMaster>`
+
+const pptxSlideMasterRelsXML = xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+  <Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/slideLayout" Target="../slideLayouts/slideLayout1.xml"/>
+</Relationships>`
+
+const pptxSlideLayoutXML = xml.Header + `<p:sldLayout xmlns:a="http://schemas.openxmlformats.org/drawingml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships" xmlns:p="http://schemas.openxmlformats.org/presentationml/2006/main">
+  <p:cSld>
+    <p:spTree>
+      <p:nvGrpSpPr><p:cNvPr id="1" name=""/><p:cNvGrpSpPr/><p:nvPr/></p:nvGrpSpPr>
+      <p:grpSpPr/>
+    </p:spTree>
+  </p:cSld>
+</p:sldLayout>`
+
+const pptxSlideLayoutRelsXML = xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+  <Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/slideMaster" Target="../slideMasters/slideMaster1.xml"/>
+</Relationships>`
+
+const pptxSlideRelsXML = xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+  <Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/slideLayout" Target="../slideLayouts/slideLayout1.xml"/>
+</Relationships>`
+
+// xlsxSharedStrings interns the cell text used across all worksheets into a
+// single deduplicated table, as the sharedStrings.xml part requires.
+type xlsxSharedStrings struct {
+	values []string
+	index  map[string]int
+}
+
+func newXLSXSharedStrings() *xlsxSharedStrings {
+	return &xlsxSharedStrings{index: make(map[string]int)}
+}
+
+func (s *xlsxSharedStrings) intern(v string) int {
+	if i, ok := s.index[v]; ok {
+		return i
+	}
+	i := len(s.values)
+	s.values = append(s.values, v)
+	s.index[v] = i
+	return i
+}
+
+// xlsxColumn renders a 1-based column number as its spreadsheet letter(s), e.g. 1 -> "A", 27 -> "AA".
+func xlsxColumn(col int) string {
+	var letters []byte
+	for col > 0 {
+		col--
+		letters = append([]byte{byte('A' + col%26)}, letters...)
+		col /= 26
+	}
+	return string(letters)
+}
+
+type xlsxCell struct {
+	R string `xml:"r,attr"`
+	T string `xml:"t,attr"`
+	V string `xml:"v"`
+}
+
+type xlsxRow struct {
+	R int        `xml:"r,attr"`
+	C []xlsxCell `xml:"c"`
+}
+
+type xlsxSheetData struct {
+	Row []xlsxRow `xml:"row"`
+}
+
+type xlsxWorksheet struct {
+	XMLName   xml.Name      `xml:"worksheet"`
+	Xmlns     string        `xml:"xmlns,attr"`
+	SheetData xlsxSheetData `xml:"sheetData"`
+}
+
+// buildXLSXSheet turns a header row plus data rows into a worksheet, interning
+// every cell value as a shared string.
+func buildXLSXSheet(headers []string, rows [][]string, ss *xlsxSharedStrings) xlsxWorksheet {
+	sheetData := xlsxSheetData{Row: make([]xlsxRow, 0, len(rows)+1)}
+
+	headerCells := make([]xlsxCell, len(headers))
+	for col, h := range headers {
+		headerCells[col] = xlsxCell{R: xlsxColumn(col+1) + "1", T: "s", V: strconv.Itoa(ss.intern(h))}
+	}
+	sheetData.Row = append(sheetData.Row, xlsxRow{R: 1, C: headerCells})
+
+	for i, row := range rows {
+		rowNum := i + 2
+		cells := make([]xlsxCell, len(row))
+		for col, v := range row {
+			cells[col] = xlsxCell{R: xlsxColumn(col+1) + strconv.Itoa(rowNum), T: "s", V: strconv.Itoa(ss.intern(v))}
 		}
-		mxFile.Diagram.Model.Root.Cells = append(mxFile.Diagram.Model.Root.Cells, scalarCell)
+		sheetData.Row = append(sheetData.Row, xlsxRow{R: rowNum, C: cells})
 	}
 
-	for _, directive := range d.Directives {
-		directiveNode := &ClassNode{
-			ID:       "directive_" + directive.Name,
-			Name:     "@" + directive.Name,
-			Width:    DirectiveWidth,
-			Height:   DirectiveHeight + float64(len(directive.Arguments))*FieldHeight,
-			Position: Position{},
+	return xlsxWorksheet{
+		Xmlns:     "http://schemas.openxmlformats.org/spreadsheetml/2006/main",
+		SheetData: sheetData,
+	}
+}
+
+// xlsxTypesRows builds the Types sheet: one row per field of every ClassNode,
+// or a single row with blank field columns for a type with none (e.g. a
+// union, which has no fields of its own - only Relations to its members).
+func xlsxTypesRows(d *Diagram) [][]string {
+	rows := make([][]string, 0)
+	for _, class := range d.Classes {
+		if len(class.Fields) == 0 {
+			rows = append(rows, []string{class.Name, class.Stereotype, "", "", ""})
+			continue
+		}
+		for _, field := range class.Fields {
+			rows = append(rows, []string{class.Name, class.Stereotype, field.Name, field.Type, strconv.FormatBool(field.IsRequired)})
 		}
-		d.Classes = append(d.Classes, directiveNode)
 	}
+	return rows
+}
 
-	calculateLayout(d)
+// xlsxScalarsRows builds the Scalars sheet: one row per ScalarNode.
+func xlsxScalarsRows(d *Diagram) [][]string {
+	rows := make([][]string, 0, len(d.Scalars))
+	for _, scalar := range d.Scalars {
+		rows = append(rows, []string{scalar.Name, scalar.Description})
+	}
+	return rows
+}
 
+// xlsxDirectivesRows builds the Directives sheet: one row per directive argument,
+// or a single row with blank argument columns for directives that take none.
+func xlsxDirectivesRows(d *Diagram) [][]string {
+	rows := make([][]string, 0)
 	for _, directive := range d.Directives {
-		directiveCell := MxCell{
-			ID:     "directive_" + directive.Name,
-			Value:  fmt.Sprintf("@%s\non %s", directive.Name, strings.Join(directive.Locations, ", ")),
-			Style:  DirectiveStyle,
-			Parent: "1",
-			Vertex: "1",
-			Geometry: &MxGeometry{
-				X:      directive.Position.X,
-				Y:      directive.Position.Y,
-				Width:  DirectiveWidth,
-				Height: DirectiveHeight + float64(len(directive.Arguments))*FieldHeight,
-			},
+		locations := strings.Join(directive.Locations, ", ")
+		if len(directive.Arguments) == 0 {
+			rows = append(rows, []string{directive.Name, locations, "", "", "", ""})
+			continue
 		}
-		mxFile.Diagram.Model.Root.Cells = append(mxFile.Diagram.Model.Root.Cells, directiveCell)
+		for _, arg := range directive.Arguments {
+			rows = append(rows, []string{
+				directive.Name, locations, arg.Name, arg.Type,
+				strconv.FormatBool(arg.IsRequired), arg.DefaultValue,
+			})
+		}
+	}
+	return rows
+}
 
-		for i, arg := range directive.Arguments {
-			argValue := fmt.Sprintf("%s: %s", arg.Name, arg.Type)
-			if arg.DefaultValue != "" {
-				argValue += fmt.Sprintf(" = %s", arg.DefaultValue)
-			}
+// xlsxRelationsRows builds the Relations sheet: one row per Relation.
+func xlsxRelationsRows(d *Diagram) [][]string {
+	rows := make([][]string, 0, len(d.Relations))
+	for _, rel := range d.Relations {
+		rows = append(rows, []string{rel.From, rel.To, rel.Type, rel.EdgeType})
+	}
+	return rows
+}
 
-			argCell := MxCell{
-				ID:     fmt.Sprintf("directive_%s_arg%d", directive.Name, i),
-				Value:  argValue,
-				Style:  ArgumentStyle,
-				Parent: "directive_" + directive.Name,
-				Vertex: "1",
-				Geometry: &MxGeometry{
-					X:      0,
-					Y:      DirectiveHeight + float64(i)*FieldHeight,
-					Width:  DirectiveWidth,
-					Height: FieldHeight,
-				},
-			}
-			mxFile.Diagram.Model.Root.Cells = append(mxFile.Diagram.Model.Root.Cells, argCell)
-		}
+// outputXLSX serializes the same schema model processSchema already builds
+// into a 4-sheet .xlsx workbook: Types, Scalars, Directives and Relations.
+func outputXLSX(d *Diagram) []byte {
+	ss := newXLSXSharedStrings()
+
+	sheets := []xlsxWorksheet{
+		buildXLSXSheet([]string{"Name", "Stereotype", "Field", "Type", "Required"}, xlsxTypesRows(d), ss),
+		buildXLSXSheet([]string{"Name", "Description"}, xlsxScalarsRows(d), ss),
+		buildXLSXSheet([]string{"Name", "Locations", "Argument", "Type", "Required", "Default"}, xlsxDirectivesRows(d), ss),
+		buildXLSXSheet([]string{"From", "To", "Type", "EdgeType"}, xlsxRelationsRows(d), ss),
 	}
+	sheetNames := []string{"Types", "Scalars", "Directives", "Relations"}
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	writeOOXMLZipPart(zw, "[Content_Types].xml", xlsxContentTypesXML)
+	writeOOXMLZipPart(zw, "_rels/.rels", xlsxRootRelsXML)
+	writeOOXMLZipPart(zw, "xl/workbook.xml", buildXLSXWorkbookXML(sheetNames))
+	writeOOXMLZipPart(zw, "xl/_rels/workbook.xml.rels", buildXLSXWorkbookRelsXML(len(sheets)))
+	writeOOXMLZipPart(zw, "xl/styles.xml", xlsxStylesXML)
+	writeOOXMLZipPart(zw, "xl/sharedStrings.xml", buildXLSXSharedStringsXML(ss))
+	for i, sheet := range sheets {
+		sheetXML, _ := xml.Marshal(sheet)
+		writeOOXMLZipPart(zw, fmt.Sprintf("xl/worksheets/sheet%d.xml", i+1), xml.Header+string(sheetXML))
+	}
+	zw.Close()
 
-	output, _ := xml.MarshalIndent(mxFile, "", "    ")
-	return output
+	return buf.Bytes()
 }
 
+func buildXLSXWorkbookXML(sheetNames []string) string {
+	var sheetsXML strings.Builder
+	for i, name := range sheetNames {
+		fmt.Fprintf(&sheetsXML, `<sheet name="%s" sheetId="%d" r:id="rId%d"/>`, name, i+1, i+1)
+	}
+	return xml.Header + `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+  <sheets>` + sheetsXML.String() + `</sheets>
+</workbook>`
+}
+
+func buildXLSXWorkbookRelsXML(sheetCount int) string {
+	var relsXML strings.Builder
+	for i := 0; i < sheetCount; i++ {
+		fmt.Fprintf(&relsXML, `<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet%d.xml"/>`, i+1, i+1)
+	}
+	stylesID := sheetCount + 1
+	sharedStringsID := sheetCount + 2
+	return xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		relsXML.String() +
+		fmt.Sprintf(`<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>`, stylesID) +
+		fmt.Sprintf(`<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/sharedStrings" Target="sharedStrings.xml"/>`, sharedStringsID) +
+		`</Relationships>`
+}
+
+func buildXLSXSharedStringsXML(ss *xlsxSharedStrings) string {
+	var items strings.Builder
+	for _, v := range ss.values {
+		items.WriteString("<si><t>")
+		xml.EscapeText(&items, []byte(v))
+		items.WriteString("</t></si>")
+	}
+	return xml.Header + fmt.Sprintf(`<sst xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" count="%d" uniqueCount="%d">`, len(ss.values), len(ss.values)) +
+		items.String() + `</sst>`
+}
+
+const xlsxContentTypesXML = xml.Header + `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">
+  <Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>
+  <Default Extension="xml" ContentType="application/xml"/>
+  <Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>
+  <Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>
+  <Override PartName="/xl/sharedStrings.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sharedStrings+xml"/>
+  <Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>
+  <Override PartName="/xl/worksheets/sheet2.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>
+  <Override PartName="/xl/worksheets/sheet3.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>
+  <Override PartName="/xl/worksheets/sheet4.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>
+</Types>`
+
+const xlsxRootRelsXML = xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+  <Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>
+</Relationships>`
+
+const xlsxStylesXML = xml.Header + `<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+  <fonts count="1"><font><sz val="11"/><name val="Calibri"/></font></fonts>
+  <fills count="1"><fill><patternFill patternType="none"/></fill></fills>
+  <borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>
+  <cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>
+  <cellXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/></cellXfs>
+</styleSheet>`
+
 func isNonNullType(t ast.Type) bool {
 	_, isNonNull := t.(*ast.NonNull)
 	return isNonNull
 }
 
+// resolveSchemaFiles expands -schema into the list of SDL files to parse: the
+// path itself if it's a single file, every match of a glob pattern, or every
+// *.graphqls file found by walking a directory recursively.
+func resolveSchemaFiles(path string) ([]string, error) {
+	if strings.ContainsAny(path, "*?[") {
+		matches, err := filepath.Glob(path)
+		if err != nil {
+			return nil, fmt.Errorf("expanding schema glob %q: %w", path, err)
+		}
+		if len(matches) == 0 {
+			return nil, fmt.Errorf("no files matched schema glob %q", path)
+		}
+		sort.Strings(matches)
+		return matches, nil
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading schema path %q: %w", path, err)
+	}
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+
+	var files []string
+	err = filepath.Walk(path, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() && strings.HasSuffix(p, ".graphqls") {
+			files = append(files, p)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("walking schema directory %q: %w", path, err)
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no .graphqls files found under %q", path)
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// parseSchemaFiles parses every file in order into its own AST document.
+// `extend interface`/`extend input` blocks are stripped out and parsed
+// separately first, since graphql-go v0.8.1's grammar only recognizes
+// `extend type` and fails to parse the file at all otherwise; see
+// extractUnsupportedExtensions.
+func parseSchemaFiles(paths []string) ([]*ast.Document, []rawExtension, error) {
+	docs := make([]*ast.Document, 0, len(paths))
+	var extras []rawExtension
+	for _, path := range paths {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, nil, fmt.Errorf("reading schema file %s: %w", path, err)
+		}
+
+		source, fileExtras, err := extractUnsupportedExtensions(string(data))
+		if err != nil {
+			return nil, nil, fmt.Errorf("scanning schema file %s: %w", path, err)
+		}
+		extras = append(extras, fileExtras...)
+
+		doc, err := parser.Parse(parser.ParseParams{Source: source})
+		if err != nil {
+			return nil, nil, fmt.Errorf("parsing schema file %s: %w", path, err)
+		}
+		docs = append(docs, doc)
+	}
+	return docs, extras, nil
+}
+
+// rawExtension is an `extend interface X { ... }` or `extend input X { ... }`
+// block pulled out of a schema file by extractUnsupportedExtensions before
+// parsing, since graphql-go v0.8.1's parser has no grammar for either form.
+type rawExtension struct {
+	Kind string // "interface" or "input"
+	Name string
+	Body string
+}
+
+var extendInterfaceOrInputRE = regexp.MustCompile(`extend\s+(interface|input)\s+([A-Za-z_][A-Za-z0-9_]*)\s*\{`)
+
+// extractUnsupportedExtensions removes every `extend interface X { ... }` and
+// `extend input X { ... }` block from source, returning the remaining
+// (parseable) source plus the extracted blocks as rawExtensions. Braces are
+// matched by depth so multi-field blocks are captured in full.
+func extractUnsupportedExtensions(source string) (string, []rawExtension, error) {
+	var out strings.Builder
+	var extras []rawExtension
+
+	i := 0
+	for {
+		loc := extendInterfaceOrInputRE.FindStringSubmatchIndex(source[i:])
+		if loc == nil {
+			out.WriteString(source[i:])
+			break
+		}
+
+		matchStart := i + loc[0]
+		braceOpen := i + loc[1] - 1
+		kind := source[i+loc[2] : i+loc[3]]
+		name := source[i+loc[4] : i+loc[5]]
+
+		depth := 1
+		pos := braceOpen + 1
+		for depth > 0 {
+			if pos >= len(source) {
+				return "", nil, fmt.Errorf("extend %s %s: unterminated block", kind, name)
+			}
+			switch source[pos] {
+			case '{':
+				depth++
+			case '}':
+				depth--
+			}
+			pos++
+		}
+
+		out.WriteString(source[i:matchStart])
+		extras = append(extras, rawExtension{
+			Kind: kind,
+			Name: name,
+			Body: source[braceOpen+1 : pos-1],
+		})
+		i = pos
+	}
+
+	return out.String(), extras, nil
+}
+
+// definitionName returns the name of any top-level SDL definition that
+// introduces a new type-system name, for duplicate detection across files.
+func definitionName(def ast.Node) (string, bool) {
+	switch def := def.(type) {
+	case *ast.ObjectDefinition:
+		return def.Name.Value, true
+	case *ast.InterfaceDefinition:
+		return def.Name.Value, true
+	case *ast.UnionDefinition:
+		return def.Name.Value, true
+	case *ast.EnumDefinition:
+		return def.Name.Value, true
+	case *ast.InputObjectDefinition:
+		return def.Name.Value, true
+	case *ast.ScalarDefinition:
+		return def.Name.Value, true
+	case *ast.DirectiveDefinition:
+		return def.Name.Value, true
+	default:
+		return "", false
+	}
+}
+
+// mergeDocuments combines multiple parsed SDL documents into one, folding any
+// `extend type X { ... }` into the base ObjectDefinition X defined elsewhere
+// (in the same or a different file), merging the `extend interface`/
+// `extend input` blocks already pulled out by extractUnsupportedExtensions
+// into their base definitions, and erroring on duplicate top-level names.
+func mergeDocuments(docs []*ast.Document, extras []rawExtension) (*ast.Document, error) {
+	if len(docs) == 0 {
+		return &ast.Document{Kind: "Document"}, nil
+	}
+
+	baseObjects := make(map[string]*ast.ObjectDefinition)
+	baseInterfaces := make(map[string]*ast.InterfaceDefinition)
+	baseInputObjects := make(map[string]*ast.InputObjectDefinition)
+	seen := make(map[string]string)
+	var ordered []ast.Node
+	var extensions []*ast.TypeExtensionDefinition
+
+	for _, doc := range docs {
+		for _, def := range doc.Definitions {
+			if ext, ok := def.(*ast.TypeExtensionDefinition); ok {
+				extensions = append(extensions, ext)
+				continue
+			}
+
+			if name, ok := definitionName(def); ok {
+				if prevKind, dup := seen[name]; dup {
+					return nil, fmt.Errorf("duplicate definition %q (already defined as %s)", name, prevKind)
+				}
+				seen[name] = fmt.Sprintf("%T", def)
+			}
+
+			switch def := def.(type) {
+			case *ast.ObjectDefinition:
+				baseObjects[def.Name.Value] = def
+			case *ast.InterfaceDefinition:
+				baseInterfaces[def.Name.Value] = def
+			case *ast.InputObjectDefinition:
+				baseInputObjects[def.Name.Value] = def
+			}
+
+			ordered = append(ordered, def)
+		}
+	}
+
+	for _, ext := range extensions {
+		name := ext.Definition.Name.Value
+		base, ok := baseObjects[name]
+		if !ok {
+			return nil, fmt.Errorf("extend type %s: no base type %q defined in any schema file", name, name)
+		}
+		base.Fields = append(base.Fields, ext.Definition.Fields...)
+	}
+
+	for _, extra := range extras {
+		if err := mergeRawExtension(extra, baseInterfaces, baseInputObjects); err != nil {
+			return nil, err
+		}
+	}
+
+	return &ast.Document{Kind: docs[0].Kind, Definitions: ordered}, nil
+}
+
+// mergeRawExtension parses the body of an `extend interface`/`extend input`
+// block (already stripped out of its source file by
+// extractUnsupportedExtensions, since graphql-go v0.8.1's parser can't parse
+// either form directly) as a standalone definition and folds its fields into
+// the matching base interface or input object.
+func mergeRawExtension(extra rawExtension, baseInterfaces map[string]*ast.InterfaceDefinition, baseInputObjects map[string]*ast.InputObjectDefinition) error {
+	doc, err := parser.Parse(parser.ParseParams{
+		Source: fmt.Sprintf("%s %s {%s}", extra.Kind, extra.Name, extra.Body),
+	})
+	if err != nil {
+		return fmt.Errorf("extend %s %s: %w", extra.Kind, extra.Name, err)
+	}
+	if len(doc.Definitions) != 1 {
+		return fmt.Errorf("extend %s %s: expected a single definition, got %d", extra.Kind, extra.Name, len(doc.Definitions))
+	}
+
+	switch extra.Kind {
+	case "interface":
+		def, ok := doc.Definitions[0].(*ast.InterfaceDefinition)
+		if !ok {
+			return fmt.Errorf("extend interface %s: failed to parse extension body", extra.Name)
+		}
+		base, ok := baseInterfaces[extra.Name]
+		if !ok {
+			return fmt.Errorf("extend interface %s: no base interface %q defined in any schema file", extra.Name, extra.Name)
+		}
+		base.Fields = append(base.Fields, def.Fields...)
+	case "input":
+		def, ok := doc.Definitions[0].(*ast.InputObjectDefinition)
+		if !ok {
+			return fmt.Errorf("extend input %s: failed to parse extension body", extra.Name)
+		}
+		base, ok := baseInputObjects[extra.Name]
+		if !ok {
+			return fmt.Errorf("extend input %s: no base input %q defined in any schema file", extra.Name, extra.Name)
+		}
+		base.Fields = append(base.Fields, def.Fields...)
+	default:
+		return fmt.Errorf("extend %s %s: unsupported extension kind", extra.Kind, extra.Name)
+	}
+
+	return nil
+}
+
 func processSchema(doc *ast.Document, diagram *Diagram) {
+	filters := diagram.Config.Filters
+
 	for _, def := range doc.Definitions {
 		switch def := def.(type) {
 		case *ast.ObjectDefinition:
+			if !matchesTypeFilters(def.Name.Value, filters) {
+				continue
+			}
+
 			class := &ClassNode{
 				ID:   "c" + def.Name.Value,
 				Name: def.Name.Value,
@@ -412,13 +1555,114 @@ func processSchema(doc *ast.Document, diagram *Diagram) {
 					})
 				}
 			}
+
+			for _, iface := range def.Interfaces {
+				diagram.Relations = append(diagram.Relations, Relation{
+					From:     def.Name.Value,
+					To:       iface.Name.Value,
+					Type:     "implements",
+					EdgeType: "interface",
+				})
+			}
+		case *ast.InterfaceDefinition:
+			if !matchesTypeFilters(def.Name.Value, filters) {
+				continue
+			}
+
+			class := &ClassNode{
+				ID:         "c" + def.Name.Value,
+				Name:       def.Name.Value,
+				Stereotype: "interface",
+			}
+
+			for _, field := range def.Fields {
+				class.Fields = append(class.Fields, Field{
+					Name:       field.Name.Value,
+					Type:       getTypeString(field.Type),
+					IsRequired: isNonNullType(field.Type),
+				})
+			}
+
+			diagram.Classes = append(diagram.Classes, class)
+		case *ast.UnionDefinition:
+			if !matchesTypeFilters(def.Name.Value, filters) {
+				continue
+			}
+
+			class := &ClassNode{
+				ID:         "c" + def.Name.Value,
+				Name:       def.Name.Value,
+				Stereotype: "union",
+			}
+			diagram.Classes = append(diagram.Classes, class)
+
+			for _, member := range def.Types {
+				diagram.Relations = append(diagram.Relations, Relation{
+					From:     def.Name.Value,
+					To:       member.Name.Value,
+					Type:     "member",
+					EdgeType: "union",
+				})
+			}
+		case *ast.EnumDefinition:
+			if !matchesTypeFilters(def.Name.Value, filters) {
+				continue
+			}
+
+			class := &ClassNode{
+				ID:         "c" + def.Name.Value,
+				Name:       def.Name.Value,
+				Stereotype: "enum",
+			}
+
+			for _, value := range def.Values {
+				class.Fields = append(class.Fields, Field{Name: value.Name.Value})
+			}
+
+			diagram.Classes = append(diagram.Classes, class)
+		case *ast.InputObjectDefinition:
+			if !matchesTypeFilters(def.Name.Value, filters) {
+				continue
+			}
+
+			class := &ClassNode{
+				ID:         "c" + def.Name.Value,
+				Name:       def.Name.Value,
+				Stereotype: "input",
+			}
+
+			for _, field := range def.Fields {
+				class.Fields = append(class.Fields, Field{
+					Name:       field.Name.Value,
+					Type:       getTypeString(field.Type),
+					IsRequired: isNonNullType(field.Type),
+				})
+			}
+
+			diagram.Classes = append(diagram.Classes, class)
+
+			for _, field := range def.Fields {
+				if isObjectType(field.Type, doc) || isInputType(field.Type, doc) {
+					diagram.Relations = append(diagram.Relations, Relation{
+						From: def.Name.Value,
+						To:   getBaseType(field.Type),
+						Type: "uses",
+					})
+				}
+			}
 		case *ast.ScalarDefinition:
+			if filters.HideBuiltins && isBuiltinScalarName(def.Name.Value) {
+				continue
+			}
 			scalar := &ScalarNode{
 				Name:        def.Name.Value,
 				Description: getDescription(def.Description),
 			}
 			diagram.Scalars = append(diagram.Scalars, scalar)
 		case *ast.DirectiveDefinition:
+			if filters.HideBuiltins && isBuiltinDirectiveName(def.Name.Value) {
+				continue
+			}
 			directive := &DirectiveNode{
 				Name:        def.Name.Value,
 				Description: getDescription(def.Description),
@@ -459,10 +1703,79 @@ func processSchema(doc *ast.Document, diagram *Diagram) {
 	}
 }
 
+// pruneDanglingRelations removes any Relation whose From or To no longer
+// names a surviving class, scalar or directive. processSchema's
+// IncludeTypes/ExcludeTypes filtering only stops a ClassNode from being
+// created; without this pass, relations into the excluded type would still
+// be emitted and renderers would draw edges to/from nodes that don't exist.
+func pruneDanglingRelations(d *Diagram) {
+	known := make(map[string]bool, len(d.Classes)+len(d.Scalars)+len(d.Directives))
+	for _, class := range d.Classes {
+		known[class.Name] = true
+	}
+	for _, scalar := range d.Scalars {
+		known[scalar.Name] = true
+	}
+	for _, directive := range d.Directives {
+		known["@"+directive.Name] = true
+	}
+
+	kept := d.Relations[:0]
+	for _, rel := range d.Relations {
+		if known[rel.From] && known[rel.To] {
+			kept = append(kept, rel)
+		}
+	}
+	d.Relations = kept
+}
+
+// applyRenderFilters drops scalars/directives/types (and the relations that
+// point at them) per Config.Filters before a diagram is rendered, regardless
+// of output format. It runs after processSchema, which already skips
+// creating ClassNodes for types excluded by IncludeTypes/ExcludeTypes but
+// still leaves behind any Relations that referenced them.
+func applyRenderFilters(d *Diagram) {
+	pruneDanglingRelations(d)
+
+	if d.Config.Filters.HideDirectives {
+		d.Directives = nil
+		kept := d.Relations[:0]
+		for _, rel := range d.Relations {
+			if rel.EdgeType != "directive" {
+				kept = append(kept, rel)
+			}
+		}
+		d.Relations = kept
+	}
+
+	if d.Config.Filters.HideScalars {
+		hidden := make(map[string]bool, len(d.Scalars))
+		for _, scalar := range d.Scalars {
+			hidden[scalar.Name] = true
+		}
+		d.Scalars = nil
+		kept := d.Relations[:0]
+		for _, rel := range d.Relations {
+			if !hidden[rel.To] {
+				kept = append(kept, rel)
+			}
+		}
+		d.Relations = kept
+	}
+}
+
 func outputDiagram(d *Diagram) {
+	applyRenderFilters(d)
+
 	if d.format == DrawIO {
 		output := generateDrawIOXML(d)
 		fmt.Println(string(output))
+	} else if d.format == PowerPoint {
+		output := generatePPTX(d)
+		fmt.Println(string(output))
+	} else if d.format == XLSX {
+		output := outputXLSX(d)
+		fmt.Println(string(output))
 	} else {
 		fmt.Println("classDiagram")
 
@@ -483,7 +1796,14 @@ func outputDiagram(d *Diagram) {
 		}
 		for _, class := range d.Classes {
 			fmt.Printf("class %s {\n", class.Name)
+			if class.Stereotype != "" {
+				fmt.Printf("    <<%s>>\n", class.Stereotype)
+			}
 			for _, field := range class.Fields {
+				if field.Type == "" {
+					fmt.Printf("    %s\n", field.Name)
+					continue
+				}
 				fmt.Printf("    +%s %s\n", field.Name, field.Type)
 			}
 			fmt.Println("}")
@@ -491,8 +1811,13 @@ func outputDiagram(d *Diagram) {
 
 		for _, relation := range d.Relations {
 			style := "-->"
-			if relation.EdgeType == "directive" {
+			switch relation.EdgeType {
+			case "directive":
 				style = "..>"
+			case "interface":
+				style = "..|>"
+			case "union":
+				style = "--o"
 			}
 			fmt.Printf("%s %s %s : %s\n", relation.From, style, relation.To, relation.Type)
 		}
@@ -501,22 +1826,36 @@ func outputDiagram(d *Diagram) {
 
 func main() {
 	// Add format flag
-	formatFlag := flag.String("format", "mermaid", "Output format: mermaid or drawio")
+	formatFlag := flag.String("format", "mermaid", "Output format: mermaid, drawio, pptx or xlsx")
+	configFlag := flag.String("config", "", "Path to a JSON or YAML config file for styling, sizing and filters")
+	schemaFlag := flag.String("schema", "test.graphqls", "Schema file, glob (schema/*.graphqls), or directory to parse and merge")
 	flag.Parse()
 
-	// Read the GraphQL schema file
-	schemaBytes, err := ioutil.ReadFile("test.graphqls")
+	// Resolve -schema to one or more files, parse each, and merge them (with
+	// `extend type`/`extend interface`/`extend input` resolution) into a
+	// single document.
+	schemaFiles, err := resolveSchemaFiles(*schemaFlag)
 	if err != nil {
-		log.Fatalf("Error reading schema file: %v", err)
+		log.Fatalf("Error resolving schema path: %v", err)
 	}
-
-	// Parse the GraphQL schema
-	doc, err := parser.Parse(parser.ParseParams{
-		Source: string(schemaBytes),
-	})
+	schemaDocs, schemaExtras, err := parseSchemaFiles(schemaFiles)
 	if err != nil {
 		log.Fatalf("Error parsing schema: %v", err)
 	}
+	doc, err := mergeDocuments(schemaDocs, schemaExtras)
+	if err != nil {
+		log.Fatalf("Error merging schema files: %v", err)
+	}
+
+	// Load the layout/style/filter config, falling back to the baked-in
+	// defaults when -config isn't given.
+	cfg := defaultConfig()
+	if *configFlag != "" {
+		cfg, err = loadConfig(*configFlag)
+		if err != nil {
+			log.Fatalf("Error loading config: %v", err)
+		}
+	}
 
 	// Create new Diagram
 	diagram := &Diagram{
@@ -525,13 +1864,18 @@ func main() {
 		Scalars:    make([]*ScalarNode, 0),
 		Directives: make([]*DirectiveNode, 0),
 		format:     Mermaid,
-		maxWidth:   1920, // Standard screen width
-		maxHeight:  1080, // Standard screen height
+		maxWidth:   cfg.MaxWidth,
+		maxHeight:  cfg.MaxHeight,
+		Config:     cfg,
 	}
 
 	// Set format based on flag
 	if *formatFlag == "drawio" {
 		diagram.format = DrawIO
+	} else if *formatFlag == "pptx" {
+		diagram.format = PowerPoint
+	} else if *formatFlag == "xlsx" {
+		diagram.format = XLSX
 	}
 
 	// Process the schema
@@ -0,0 +1,331 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+)
+
+// TestPruneDanglingRelationsDropsEdgesToExcludedTypes reproduces the
+// regression reported against chunk0-4: filtering a diagram down to a
+// single included type must also drop Relations that still pointed at
+// types/directives excluded from Classes/Directives, not just skip
+// creating their ClassNodes.
+func TestPruneDanglingRelationsDropsEdgesToExcludedTypes(t *testing.T) {
+	d := &Diagram{
+		Classes: []*ClassNode{
+			{ID: "cPost", Name: "Post"},
+		},
+		Directives: nil,
+		Relations: []Relation{
+			{From: "Post", To: "User", Type: "uses"},
+			{From: "@auth", To: "Role", Type: "uses", EdgeType: "directive"},
+		},
+	}
+
+	pruneDanglingRelations(d)
+
+	if len(d.Relations) != 0 {
+		t.Fatalf("expected all relations referencing excluded types to be dropped, got %+v", d.Relations)
+	}
+}
+
+func TestPruneDanglingRelationsKeepsSurvivingEdges(t *testing.T) {
+	d := &Diagram{
+		Classes: []*ClassNode{
+			{ID: "cPost", Name: "Post"},
+			{ID: "cUser", Name: "User"},
+		},
+		Directives: []*DirectiveNode{
+			{Name: "auth"},
+		},
+		Relations: []Relation{
+			{From: "Post", To: "User", Type: "uses"},
+			{From: "@auth", To: "Post", Type: "uses", EdgeType: "directive"},
+		},
+	}
+
+	pruneDanglingRelations(d)
+
+	if len(d.Relations) != 2 {
+		t.Fatalf("expected surviving relations to be kept, got %+v", d.Relations)
+	}
+}
+
+// TestExtractUnsupportedExtensions covers the chunk0-5 gap: `extend
+// interface`/`extend input` can't be parsed by graphql-go v0.8.1 directly,
+// so they must be pulled out of the source before parser.Parse ever sees it.
+func TestExtractUnsupportedExtensions(t *testing.T) {
+	source := `
+interface Node { id: ID! }
+
+extend interface Node {
+  createdAt: String
+}
+
+input Filter { limit: Int }
+
+extend input Filter {
+  offset: Int
+}
+
+type Query { node: Node }
+`
+	stripped, extras, err := extractUnsupportedExtensions(source)
+	if err != nil {
+		t.Fatalf("extractUnsupportedExtensions: %v", err)
+	}
+	if len(extras) != 2 {
+		t.Fatalf("expected 2 extracted extensions, got %d: %+v", len(extras), extras)
+	}
+	if extras[0].Kind != "interface" || extras[0].Name != "Node" {
+		t.Fatalf("unexpected first extension: %+v", extras[0])
+	}
+	if extras[1].Kind != "input" || extras[1].Name != "Filter" {
+		t.Fatalf("unexpected second extension: %+v", extras[1])
+	}
+
+	doc, err := parser.Parse(parser.ParseParams{Source: stripped})
+	if err != nil {
+		t.Fatalf("stripped source failed to parse: %v\nsource:\n%s", err, stripped)
+	}
+	if len(doc.Definitions) != 3 {
+		t.Fatalf("expected 3 remaining definitions (Node, Filter, Query), got %d", len(doc.Definitions))
+	}
+}
+
+// TestMergeDocumentsFoldsInterfaceAndInputExtensions verifies that fields
+// from `extend interface`/`extend input` blocks end up on their base
+// definitions after mergeDocuments runs.
+func TestMergeDocumentsFoldsInterfaceAndInputExtensions(t *testing.T) {
+	stripped, extras, err := extractUnsupportedExtensions(`
+interface Node { id: ID! }
+
+extend interface Node {
+  createdAt: String
+}
+
+input Filter { limit: Int }
+
+extend input Filter {
+  offset: Int
+}
+
+type Query { node: Node }
+`)
+	if err != nil {
+		t.Fatalf("extractUnsupportedExtensions: %v", err)
+	}
+
+	doc, err := parser.Parse(parser.ParseParams{Source: stripped})
+	if err != nil {
+		t.Fatalf("parsing stripped source: %v", err)
+	}
+
+	merged, err := mergeDocuments([]*ast.Document{doc}, extras)
+	if err != nil {
+		t.Fatalf("mergeDocuments: %v", err)
+	}
+
+	var node *ast.InterfaceDefinition
+	var filter *ast.InputObjectDefinition
+	for _, def := range merged.Definitions {
+		switch def := def.(type) {
+		case *ast.InterfaceDefinition:
+			node = def
+		case *ast.InputObjectDefinition:
+			filter = def
+		}
+	}
+	if node == nil || len(node.Fields) != 2 {
+		t.Fatalf("expected Node to have 2 fields after merge, got %+v", node)
+	}
+	if filter == nil || len(filter.Fields) != 2 {
+		t.Fatalf("expected Filter to have 2 fields after merge, got %+v", filter)
+	}
+}
+
+// TestGenerateDrawIOXMLEnumFieldHasNoTrailingColon reproduces the chunk0-5
+// regression where enum values (Field.Type == "") rendered as "ADMIN: " in
+// DrawIO output instead of the bare name the mermaid path already produced.
+func TestGenerateDrawIOXMLEnumFieldHasNoTrailingColon(t *testing.T) {
+	d := &Diagram{
+		Classes: []*ClassNode{
+			{
+				ID:         "cRole",
+				Name:       "Role",
+				Stereotype: "enum",
+				Fields: []Field{
+					{Name: "ADMIN"},
+					{Name: "USER"},
+				},
+			},
+		},
+		Config: defaultConfig(),
+	}
+
+	output := string(generateDrawIOXML(d))
+	if strings.Contains(output, "ADMIN:") {
+		t.Fatalf("expected enum field value without a trailing colon, got:\n%s", output)
+	}
+	if !strings.Contains(output, `value="ADMIN"`) {
+		t.Fatalf("expected bare enum value \"ADMIN\", got:\n%s", output)
+	}
+}
+
+// TestCalculateLayoutBarnesHutDoesNotOverflow reproduces the chunk0-3
+// regression: a node count above barnesHutThreshold, with several nodes
+// sharing the exact same coincident position (as boundary clamping can
+// produce), used to recurse forever subdividing a quadTree cell that never
+// separates them, crashing with a stack overflow. It must now converge.
+func TestCalculateLayoutBarnesHutDoesNotOverflow(t *testing.T) {
+	const n = barnesHutThreshold + 51
+
+	classes := make([]*ClassNode, 0, n)
+	for i := 0; i < n; i++ {
+		pos := Position{X: 10, Y: 10}
+		if i%3 == 0 {
+			// Spread a third of the nodes out so the tree isn't degenerate.
+			pos = Position{X: float64(i), Y: float64(i)}
+		}
+		classes = append(classes, &ClassNode{
+			ID:       fmt.Sprintf("cT%d", i),
+			Name:     fmt.Sprintf("T%d", i),
+			Position: pos,
+		})
+	}
+
+	d := &Diagram{
+		Classes:    classes,
+		maxWidth:   1920,
+		maxHeight:  1080,
+		Iterations: 10,
+	}
+
+	calculateLayout(d)
+}
+
+// TestBuildPPTXPresentationXMLMatchesCanvasSize reproduces the chunk0-1
+// regression where the slide size was hardcoded to 12192000x6858000 EMU
+// regardless of d.maxWidth/d.maxHeight, so shapes laid out on a larger
+// canvas rendered off-slide.
+func TestBuildPPTXPresentationXMLMatchesCanvasSize(t *testing.T) {
+	xmlStr := buildPPTXPresentationXML(1920, 1080)
+	wantCx := fmt.Sprintf(`cx="%d"`, int64(1920*emuPerPixel))
+	wantCy := fmt.Sprintf(`cy="%d"`, int64(1080*emuPerPixel))
+	if !strings.Contains(xmlStr, wantCx) {
+		t.Fatalf("expected slide size to contain %s, got:\n%s", wantCx, xmlStr)
+	}
+	if !strings.Contains(xmlStr, wantCy) {
+		t.Fatalf("expected slide size to contain %s, got:\n%s", wantCy, xmlStr)
+	}
+}
+
+// TestProcessSchemaRendersInputObjectDefinition reproduces the chunk0-5 gap
+// where `input` types (including ones merged via `extend input`) were parsed
+// but never turned into a ClassNode in any output format.
+func TestProcessSchemaRendersInputObjectDefinition(t *testing.T) {
+	doc, err := parser.Parse(parser.ParseParams{Source: `
+input Filter {
+  limit: Int
+  offset: Int
+}
+
+type Query {
+  f: Filter
+}
+`})
+	if err != nil {
+		t.Fatalf("parsing schema: %v", err)
+	}
+
+	d := &Diagram{Config: defaultConfig()}
+	processSchema(doc, d)
+
+	var filter *ClassNode
+	for _, class := range d.Classes {
+		if class.Name == "Filter" {
+			filter = class
+		}
+	}
+	if filter == nil {
+		t.Fatalf("expected a ClassNode for input type Filter, got classes: %+v", d.Classes)
+	}
+	if filter.Stereotype != "input" {
+		t.Fatalf("expected Filter's stereotype to be \"input\", got %q", filter.Stereotype)
+	}
+	if len(filter.Fields) != 2 {
+		t.Fatalf("expected Filter to have 2 fields, got %+v", filter.Fields)
+	}
+}
+
+// TestXLSXTypesRowsIncludesStereotypeAndZeroFieldTypes reproduces the
+// chunk0-5 gap where the XLSX "Types" sheet had no Stereotype column and
+// silently dropped any type with zero Fields (every union).
+func TestXLSXTypesRowsIncludesStereotypeAndZeroFieldTypes(t *testing.T) {
+	d := &Diagram{
+		Classes: []*ClassNode{
+			{Name: "User", Fields: []Field{{Name: "id", Type: "ID!", IsRequired: true}}},
+			{Name: "SearchResult", Stereotype: "union"},
+		},
+	}
+
+	rows := xlsxTypesRows(d)
+
+	var userRow, searchResultRow []string
+	for _, row := range rows {
+		switch row[0] {
+		case "User":
+			userRow = row
+		case "SearchResult":
+			searchResultRow = row
+		}
+	}
+	if userRow == nil {
+		t.Fatalf("expected a row for User, got rows: %+v", rows)
+	}
+	if searchResultRow == nil {
+		t.Fatalf("expected a row for zero-field union SearchResult, got rows: %+v", rows)
+	}
+	if searchResultRow[1] != "union" {
+		t.Fatalf("expected SearchResult's stereotype column to be \"union\", got %+v", searchResultRow)
+	}
+}
+
+// TestBuildPPTXShapeIncludesStereotypeSuffix reproduces the chunk0-5 gap
+// where PPTX shape text never got the "«stereotype»" suffix mermaid/drawio
+// already render.
+func TestBuildPPTXShapeIncludesStereotypeSuffix(t *testing.T) {
+	sp := buildPPTXShape(1, "Node", "Node «interface»", Position{}, 100, 50)
+	if len(sp.TxBody.P) != 1 || sp.TxBody.P[0].R.T != "Node «interface»" {
+		t.Fatalf("expected shape text to include the stereotype suffix, got %+v", sp.TxBody)
+	}
+}
+
+// TestValidateFilterPatternsRejectsBadRegex reproduces the chunk0-4 gap
+// where an IncludeTypes/ExcludeTypes pattern that fails regexp.Compile was
+// silently treated as a non-match instead of surfaced as a config error, so
+// a single typo could silently empty an entire diagram.
+func TestValidateFilterPatternsRejectsBadRegex(t *testing.T) {
+	if err := validateFilterPatterns(FilterConfig{IncludeTypes: []string{"["}}); err == nil {
+		t.Fatal("expected an error for an invalid includeTypes pattern, got nil")
+	}
+	if err := validateFilterPatterns(FilterConfig{ExcludeTypes: []string{"("}}); err == nil {
+		t.Fatal("expected an error for an invalid excludeTypes pattern, got nil")
+	}
+	if err := validateFilterPatterns(FilterConfig{IncludeTypes: []string{"^User$"}}); err != nil {
+		t.Fatalf("expected a valid pattern to pass, got %v", err)
+	}
+}
+
+func TestClampEMUStaysWithinPowerPointLimits(t *testing.T) {
+	if got := clampEMU(10); got != pptxMinSlideEMU {
+		t.Fatalf("expected tiny canvas to clamp up to %d, got %d", pptxMinSlideEMU, got)
+	}
+	if got := clampEMU(1e12); got != pptxMaxSlideEMU {
+		t.Fatalf("expected huge canvas to clamp down to %d, got %d", pptxMaxSlideEMU, got)
+	}
+}